@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+// config is the on-disk shape of anoncoin-walletgrpc's JSON config file.
+type config struct {
+	// ListenAddr is the address this gRPC server listens on, e.g. ":8443".
+	ListenAddr string `json:"listenAddr"`
+
+	// WalletdURL is the walletd json_rpc endpoint this server proxies to.
+	WalletdURL         string `json:"walletdURL"`
+	WalletdRPCPassword string `json:"walletdRPCPassword"`
+	WalletdBasicUser   string `json:"walletdBasicUser,omitempty"`
+	WalletdBasicPass   string `json:"walletdBasicPass,omitempty"`
+
+	// AuthToken, if set, is the bearer token every gRPC call must present
+	// via the "authorization: Bearer <token>" metadata entry. Leave empty
+	// to disable per-RPC auth (not recommended outside local development).
+	AuthToken string `json:"authToken,omitempty"`
+
+	// TLSCertFile and TLSKeyFile, if both set, are used to serve the gRPC
+	// endpoint over TLS. Leave unset to serve over plaintext.
+	TLSCertFile string `json:"tlsCertFile,omitempty"`
+	TLSKeyFile  string `json:"tlsKeyFile,omitempty"`
+}
+
+// loadConfig reads and validates the JSON config file at path.
+func loadConfig(path string) (config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return config{}, errors.Wrap(err, "reading config file")
+	}
+
+	var cfg config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return config{}, errors.Wrap(err, "parsing config file")
+	}
+
+	if cfg.ListenAddr == "" {
+		return config{}, errors.New("config: listenAddr is required")
+	}
+	if cfg.WalletdURL == "" {
+		return config{}, errors.New("config: walletdURL is required")
+	}
+	if (cfg.TLSCertFile == "") != (cfg.TLSKeyFile == "") {
+		return config{}, errors.New("config: tlsCertFile and tlsKeyFile must be set together")
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,81 @@
+// Command anoncoin-walletgrpc exposes turtlecoinwalletdrpcgo.Client over
+// gRPC, so the Electron/Qt front-end and third-party integrators can talk
+// to a single, versioned, strongly-typed API instead of hand-rolling
+// JSON-RPC calls against walletd directly.
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+	"net"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/anoncoinonline/Anoncoin-Wallet/turtlecoinwalletdrpcgo"
+	"github.com/anoncoinonline/Anoncoin-Wallet/walletgrpc"
+	"github.com/anoncoinonline/Anoncoin-Wallet/walletgrpc/walletgrpcpb"
+)
+
+func main() {
+	configPath := flag.String("config", "anoncoin-walletgrpc.json", "path to the JSON config file")
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		log.Fatalf("loading config %s: %v", *configPath, err)
+	}
+
+	client := turtlecoinwalletdrpcgo.NewClient(turtlecoinwalletdrpcgo.Config{
+		URL:           cfg.WalletdURL,
+		RPCPassword:   cfg.WalletdRPCPassword,
+		HTTPBasicUser: cfg.WalletdBasicUser,
+		HTTPBasicPass: cfg.WalletdBasicPass,
+	})
+
+	opts, err := serverOptions(cfg)
+	if err != nil {
+		log.Fatalf("building server options: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
+	if err != nil {
+		log.Fatalf("listening on %s: %v", cfg.ListenAddr, err)
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	walletgrpcpb.RegisterWalletServiceServer(grpcServer, walletgrpc.NewServer(client))
+
+	log.Infof("anoncoin-walletgrpc listening on %s", cfg.ListenAddr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("serving: %v", err)
+	}
+}
+
+// serverOptions builds the grpc.ServerOption set implied by cfg: TLS
+// transport credentials when a cert/key pair is configured, and bearer
+// token auth interceptors when an auth token is configured.
+func serverOptions(cfg config) ([]grpc.ServerOption, error) {
+	var opts []grpc.ServerOption
+
+	if cfg.TLSCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "loading TLS keypair")
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{cert},
+		})))
+	}
+
+	if cfg.AuthToken != "" {
+		opts = append(opts,
+			grpc.UnaryInterceptor(walletgrpc.TokenAuthInterceptor(cfg.AuthToken)),
+			grpc.StreamInterceptor(walletgrpc.StreamTokenAuthInterceptor(cfg.AuthToken)),
+		)
+	}
+
+	return opts, nil
+}
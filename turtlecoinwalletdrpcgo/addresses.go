@@ -0,0 +1,153 @@
+package turtlecoinwalletdrpcgo
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Balance holds the available and locked amounts of a single address, in
+// atomic units.
+type Balance struct {
+	Available int64
+	Locked    int64
+	Total     int64
+}
+
+// Output is a single unspent transaction output, as returned by
+// GetUnspentOutputs, suitable for building coin-control style UIs.
+type Output struct {
+	TxHash            string
+	OutputIndex       int
+	Amount            int64
+	Address           string
+	GlobalOutputIndex int
+}
+
+// createAddressResult mirrors walletd's createAddress result.
+type createAddressResult struct {
+	Address string `json:"address"`
+}
+
+// CreateAddress creates a new address in the wallet container and returns it.
+func (c *Client) CreateAddress(ctx context.Context) (address string, err error) {
+	var result createAddressResult
+	if err := c.call(ctx, "createAddress", nil, &result); err != nil {
+		return "", errors.Wrap(err, "createAddress failed")
+	}
+	return result.Address, nil
+}
+
+// createAddressListResult mirrors walletd's createAddressList result.
+type createAddressListResult struct {
+	Addresses []string `json:"addresses"`
+}
+
+// CreateAddressList creates count new addresses in the wallet container and
+// returns them.
+func (c *Client) CreateAddressList(ctx context.Context, count int) (addresses []string, err error) {
+	if count <= 0 {
+		return nil, errors.New("CreateAddressList: count must be > 0")
+	}
+
+	// walletd's createAddressList takes explicit spend keys rather than a
+	// count; since this wrapper only creates fresh addresses (not imports),
+	// call createAddress count times and collect the results.
+	addresses = make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		address, err := c.CreateAddress(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "CreateAddressList: creating address %d/%d", i+1, count)
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+// DeleteAddress removes address from the wallet container. Any funds
+// belonging to it become unrecoverable, mirroring walletd's own semantics.
+func (c *Client) DeleteAddress(ctx context.Context, address string) error {
+	params := map[string]interface{}{"address": address}
+	return errors.Wrap(c.call(ctx, "deleteAddress", params, nil), "deleteAddress failed")
+}
+
+// ListAddresses returns every address held in the wallet container.
+func (c *Client) ListAddresses(ctx context.Context) ([]string, error) {
+	var result AddressesResult
+	if err := c.call(ctx, "getAddresses", nil, &result); err != nil {
+		return nil, errors.Wrap(err, "getAddresses failed")
+	}
+	return result.Addresses, nil
+}
+
+// BalanceForAddress returns the balance of a single address.
+func (c *Client) BalanceForAddress(ctx context.Context, address string) (Balance, error) {
+	params := map[string]interface{}{"address": address}
+
+	var result BalanceResult
+	if err := c.call(ctx, "getBalance", params, &result); err != nil {
+		return Balance{}, errors.Wrap(err, "getBalance failed")
+	}
+
+	return Balance{
+		Available: result.AvailableBalance,
+		Locked:    result.LockedAmount,
+		Total:     result.AvailableBalance + result.LockedAmount,
+	}, nil
+}
+
+// AggregateBalance returns the balance of each address in addrs along with
+// their sum, similar to the coinsapi SUMCOINBALANCE endpoint.
+func (c *Client) AggregateBalance(ctx context.Context, addrs []string) (per map[string]Balance, total Balance, err error) {
+	per = make(map[string]Balance, len(addrs))
+
+	for _, addr := range addrs {
+		balance, err := c.BalanceForAddress(ctx, addr)
+		if err != nil {
+			return nil, Balance{}, errors.Wrapf(err, "AggregateBalance: address %s", addr)
+		}
+		per[addr] = balance
+		total.Available += balance.Available
+		total.Locked += balance.Locked
+		total.Total += balance.Total
+	}
+
+	return per, total, nil
+}
+
+// unspentOutputsResult mirrors walletd's getUnspentOuts-equivalent result.
+type unspentOutputsResult struct {
+	Outputs []struct {
+		TxHash            string `json:"transactionHash"`
+		OutputIndex       int    `json:"outputInTransaction"`
+		Amount            int64  `json:"amount"`
+		Address           string `json:"address"`
+		GlobalOutputIndex int    `json:"globalOutputIndex"`
+	} `json:"outputs"`
+}
+
+// GetUnspentOutputs lists the unspent outputs of addresses whose amount is
+// at least threshold, for building coin-control style UIs.
+func (c *Client) GetUnspentOutputs(ctx context.Context, addresses []string, threshold uint64) ([]Output, error) {
+	params := map[string]interface{}{
+		"addresses": addresses,
+		"threshold": threshold,
+	}
+
+	var result unspentOutputsResult
+	if err := c.call(ctx, "getUnspentOuts", params, &result); err != nil {
+		return nil, errors.Wrap(err, "getUnspentOuts failed")
+	}
+
+	outputs := make([]Output, len(result.Outputs))
+	for i, o := range result.Outputs {
+		outputs[i] = Output{
+			TxHash:            o.TxHash,
+			OutputIndex:       o.OutputIndex,
+			Amount:            o.Amount,
+			Address:           o.Address,
+			GlobalOutputIndex: o.GlobalOutputIndex,
+		}
+	}
+	return outputs, nil
+}
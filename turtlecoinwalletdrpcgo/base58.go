@@ -0,0 +1,112 @@
+package turtlecoinwalletdrpcgo
+
+import "github.com/pkg/errors"
+
+// cnBase58Alphabet is the CryptoNote/Monero base58 alphabet, the same one
+// Bitcoin uses: it leaves out the visually ambiguous 0, O, I and l. Only the
+// block encoding below differs from Bitcoin's base58.
+const cnBase58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+const (
+	cnBase58FullBlockSize        = 8
+	cnBase58FullEncodedBlockSize = 11
+)
+
+// cnBase58EncodedBlockSizes maps a raw block size (index) to the number of
+// base58 characters it encodes to, mirroring CryptoNote's variable-width
+// block encoding. Blocks are at most cnBase58FullBlockSize bytes, so each
+// fits comfortably in a uint64.
+var cnBase58EncodedBlockSizes = [cnBase58FullBlockSize + 1]int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+
+var cnBase58AlphabetIndex = func() map[byte]int {
+	idx := make(map[byte]int, len(cnBase58Alphabet))
+	for i := 0; i < len(cnBase58Alphabet); i++ {
+		idx[cnBase58Alphabet[i]] = i
+	}
+	return idx
+}()
+
+// cnBase58Encode encodes data using CryptoNote's block-based base58 scheme,
+// the same one wallet addresses use, rather than plain base58check.
+func cnBase58Encode(data []byte) string {
+	out := make([]byte, 0, (len(data)/cnBase58FullBlockSize+1)*cnBase58FullEncodedBlockSize)
+
+	for len(data) >= cnBase58FullBlockSize {
+		out = append(out, cnBase58EncodeBlock(data[:cnBase58FullBlockSize], cnBase58FullEncodedBlockSize)...)
+		data = data[cnBase58FullBlockSize:]
+	}
+	if len(data) > 0 {
+		out = append(out, cnBase58EncodeBlock(data, cnBase58EncodedBlockSizes[len(data)])...)
+	}
+	return string(out)
+}
+
+func cnBase58EncodeBlock(block []byte, encodedSize int) []byte {
+	var num uint64
+	for _, b := range block {
+		num = num<<8 | uint64(b)
+	}
+
+	res := make([]byte, encodedSize)
+	for i := encodedSize - 1; i >= 0; i-- {
+		res[i] = cnBase58Alphabet[num%58]
+		num /= 58
+	}
+	return res
+}
+
+// cnBase58Decode reverses cnBase58Encode. It returns an error if s contains
+// characters outside the CryptoNote alphabet or an encoded block of an
+// invalid length.
+func cnBase58Decode(s string) ([]byte, error) {
+	data := []byte(s)
+	out := make([]byte, 0, (len(data)/cnBase58FullEncodedBlockSize+1)*cnBase58FullBlockSize)
+
+	for len(data) >= cnBase58FullEncodedBlockSize {
+		block, err := cnBase58DecodeBlock(data[:cnBase58FullEncodedBlockSize], cnBase58FullBlockSize)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, block...)
+		data = data[cnBase58FullEncodedBlockSize:]
+	}
+	if len(data) > 0 {
+		rawSize := -1
+		for size, encodedSize := range cnBase58EncodedBlockSizes {
+			if encodedSize == len(data) {
+				rawSize = size
+				break
+			}
+		}
+		if rawSize < 0 {
+			return nil, errors.Errorf("cnBase58Decode: invalid encoded block length %d", len(data))
+		}
+		block, err := cnBase58DecodeBlock(data, rawSize)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, block...)
+	}
+	return out, nil
+}
+
+func cnBase58DecodeBlock(block []byte, rawSize int) ([]byte, error) {
+	var num uint64
+	for _, ch := range block {
+		digit, ok := cnBase58AlphabetIndex[ch]
+		if !ok {
+			return nil, errors.Errorf("cnBase58Decode: invalid character %q", ch)
+		}
+		num = num*58 + uint64(digit)
+	}
+
+	res := make([]byte, rawSize)
+	for i := rawSize - 1; i >= 0; i-- {
+		res[i] = byte(num & 0xff)
+		num >>= 8
+	}
+	if num != 0 {
+		return nil, errors.New("cnBase58Decode: block overflows raw size")
+	}
+	return res, nil
+}
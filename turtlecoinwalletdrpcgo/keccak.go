@@ -0,0 +1,14 @@
+package turtlecoinwalletdrpcgo
+
+import "golang.org/x/crypto/sha3"
+
+// keccak256 hashes data with the original (pre-NIST, 0x01 padded) Keccak-256
+// used throughout CryptoNote, as opposed to standard SHA3-256.
+func keccak256(data []byte) [32]byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(data)
+
+	var out [32]byte
+	copy(out[:], h.Sum(nil))
+	return out
+}
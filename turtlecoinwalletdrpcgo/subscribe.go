@@ -0,0 +1,361 @@
+package turtlecoinwalletdrpcgo
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultPollInterval is how often a subscription polls walletd when the
+// caller doesn't ask for a tighter one.
+const defaultPollInterval = 5 * time.Second
+
+// BlockEvent is delivered on the channel returned by SubscribeBlocks whenever
+// the known block count advances, or the observed block count drops (a
+// Reorg). walletd's getStatus carries no block hash, so this cannot detect
+// a same-height tip swap - only a height decrease, which is the rarer of
+// the two reorg shapes.
+type BlockEvent struct {
+	BlockCount      int
+	KnownBlockCount int
+	Reorg           bool
+}
+
+// SyncStatus is delivered on the channel returned by SubscribeSyncStatus
+// whenever walletd's sync or peer state changes.
+type SyncStatus struct {
+	BlockCount      int
+	KnownBlockCount int
+	PeerCount       int
+}
+
+// blockPoller polls getStatus and fans block/sync events out to subscribers.
+// A single goroutine backs every subscriber of a given Subscriber, so many UI
+// components can subscribe without each one hammering walletd on its own.
+// Its lifetime is tied to the ctx passed to newBlockPoller (the owning
+// Subscriber's), not to any individual consumer's context - otherwise the
+// first subscriber to disconnect would take every other subscriber's feed
+// down with it.
+type blockPoller struct {
+	mu              sync.Mutex
+	c               *Client
+	interval        time.Duration
+	blockConsumers  map[chan BlockEvent]struct{}
+	statusConsumers map[chan SyncStatus]struct{}
+	lastStatus      *StatusResult
+	ctx             context.Context
+	started         bool
+}
+
+func newBlockPoller(ctx context.Context, c *Client, interval time.Duration) *blockPoller {
+	return &blockPoller{
+		c:               c,
+		interval:        interval,
+		blockConsumers:  make(map[chan BlockEvent]struct{}),
+		statusConsumers: make(map[chan SyncStatus]struct{}),
+		ctx:             ctx,
+	}
+}
+
+func (p *blockPoller) start() {
+	p.mu.Lock()
+	if p.started {
+		p.mu.Unlock()
+		return
+	}
+	p.started = true
+	p.mu.Unlock()
+
+	go p.run(p.ctx)
+}
+
+func (p *blockPoller) run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *blockPoller) poll(ctx context.Context) {
+	var result StatusResult
+	if err := p.c.call(ctx, "getStatus", nil, &result); err != nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	prev := p.lastStatus
+	p.lastStatus = &result
+
+	if prev != nil && prev.BlockCount == result.BlockCount && prev.KnownBlockCount == result.KnownBlockCount && prev.PeerCount == result.PeerCount {
+		return
+	}
+
+	// A change in peer count alone is a SyncStatus event, not a BlockEvent.
+	if prev == nil || prev.BlockCount != result.BlockCount || prev.KnownBlockCount != result.KnownBlockCount {
+		blockEvent := BlockEvent{
+			BlockCount:      result.BlockCount,
+			KnownBlockCount: result.KnownBlockCount,
+			Reorg:           prev != nil && result.BlockCount < prev.BlockCount,
+		}
+		for ch := range p.blockConsumers {
+			select {
+			case ch <- blockEvent:
+			default:
+			}
+		}
+	}
+
+	statusEvent := SyncStatus{
+		BlockCount:      result.BlockCount,
+		KnownBlockCount: result.KnownBlockCount,
+		PeerCount:       result.PeerCount,
+	}
+	for ch := range p.statusConsumers {
+		select {
+		case ch <- statusEvent:
+		default:
+		}
+	}
+}
+
+func (p *blockPoller) addBlockConsumer(ch chan BlockEvent) {
+	p.mu.Lock()
+	p.blockConsumers[ch] = struct{}{}
+	p.mu.Unlock()
+	p.start()
+}
+
+func (p *blockPoller) removeBlockConsumer(ch chan BlockEvent) {
+	p.mu.Lock()
+	delete(p.blockConsumers, ch)
+	p.mu.Unlock()
+	close(ch)
+}
+
+func (p *blockPoller) addStatusConsumer(ch chan SyncStatus) {
+	p.mu.Lock()
+	p.statusConsumers[ch] = struct{}{}
+	p.mu.Unlock()
+	p.start()
+}
+
+func (p *blockPoller) removeStatusConsumer(ch chan SyncStatus) {
+	p.mu.Lock()
+	delete(p.statusConsumers, ch)
+	p.mu.Unlock()
+	close(ch)
+}
+
+// transferPoller polls getTransactions for a fixed set of addresses and
+// fans out newly-seen transfers, deduplicated by TxID, to its consumers.
+// Like blockPoller, its lifetime is tied to the ctx passed to
+// newTransferPoller (the owning Subscriber's), not to any individual
+// consumer's context.
+type transferPoller struct {
+	mu        sync.Mutex
+	c         *Client
+	addresses []string
+	interval  time.Duration
+	seen      map[string]struct{}
+	consumers map[chan Transfer]struct{}
+	ctx       context.Context
+	started   bool
+}
+
+func newTransferPoller(ctx context.Context, c *Client, addresses []string, interval time.Duration) *transferPoller {
+	return &transferPoller{
+		c:         c,
+		addresses: addresses,
+		interval:  interval,
+		seen:      make(map[string]struct{}),
+		consumers: make(map[chan Transfer]struct{}),
+		ctx:       ctx,
+	}
+}
+
+func (p *transferPoller) start() {
+	p.mu.Lock()
+	if p.started {
+		p.mu.Unlock()
+		return
+	}
+	p.started = true
+	p.mu.Unlock()
+
+	go p.run(p.ctx)
+}
+
+func (p *transferPoller) run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *transferPoller) poll(ctx context.Context) {
+	_, knownBlockCount, _, err := p.c.RequestStatus(ctx)
+	if err != nil {
+		return
+	}
+
+	transfers, err := p.c.RequestListTransactions(ctx, knownBlockCount, 0, p.addresses)
+	if err != nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, transfer := range transfers {
+		if _, ok := p.seen[transfer.TxID]; ok {
+			continue
+		}
+		p.seen[transfer.TxID] = struct{}{}
+
+		for ch := range p.consumers {
+			select {
+			case ch <- transfer:
+			default:
+			}
+		}
+	}
+}
+
+func (p *transferPoller) addConsumer(ch chan Transfer) {
+	p.mu.Lock()
+	p.consumers[ch] = struct{}{}
+	p.mu.Unlock()
+	p.start()
+}
+
+func (p *transferPoller) removeConsumer(ch chan Transfer) {
+	p.mu.Lock()
+	delete(p.consumers, ch)
+	p.mu.Unlock()
+	close(ch)
+}
+
+// Subscriber multiplexes one polling goroutine per event type across any
+// number of consumers, since walletd itself has no push channel. Its
+// pollers run for the lifetime of the Subscriber, independent of any single
+// Subscribe* caller's context, so one consumer disconnecting never starves
+// the others; call Close when the Subscriber itself is no longer needed.
+// Obtain one from Client.Subscriber.
+type Subscriber struct {
+	c              *Client
+	interval       time.Duration
+	mu             sync.Mutex
+	cancel         context.CancelFunc
+	blocks         *blockPoller
+	status         *blockPoller
+	transferByAddr map[string]*transferPoller
+}
+
+// Subscriber returns a new Subscriber for c, polling at defaultPollInterval.
+// Each call builds its own pollers; share one Subscriber to share them.
+// Use SubscriberWithInterval for a custom cadence.
+func (c *Client) Subscriber() *Subscriber {
+	return c.SubscriberWithInterval(defaultPollInterval)
+}
+
+// SubscriberWithInterval returns a Subscriber for c that polls walletd every
+// interval.
+func (c *Client) SubscriberWithInterval(interval time.Duration) *Subscriber {
+	ctx, cancel := context.WithCancel(context.Background())
+	poller := newBlockPoller(ctx, c, interval)
+	return &Subscriber{
+		c:              c,
+		interval:       interval,
+		cancel:         cancel,
+		blocks:         poller,
+		status:         poller,
+		transferByAddr: make(map[string]*transferPoller),
+	}
+}
+
+// Close stops every poller backing s, regardless of whether any Subscribe*
+// caller's context has been canceled. Subsequent Subscribe* calls on s will
+// no longer deliver events.
+func (s *Subscriber) Close() {
+	s.cancel()
+}
+
+// subscription lets a caller stop receiving events from a Subscriber.
+type subscription struct {
+	closeFunc func()
+	once      sync.Once
+}
+
+// Close unregisters the subscription. It is safe to call more than once.
+func (s *subscription) Close() {
+	s.once.Do(s.closeFunc)
+}
+
+// SubscribeBlocks delivers a BlockEvent whenever the wallet's observed block
+// count advances, or a Reorg event if a previously reported block height is
+// superseded by a shorter chain. ctx is accepted for symmetry with the rest
+// of the Client API; the underlying poller is shared with every other
+// subscriber and keeps running after ctx is canceled - close the returned
+// subscription (or s) to stop receiving events.
+func (s *Subscriber) SubscribeBlocks(ctx context.Context) (<-chan BlockEvent, *subscription, error) {
+	ch := make(chan BlockEvent, 1)
+	s.blocks.addBlockConsumer(ch)
+	return ch, &subscription{closeFunc: func() { s.blocks.removeBlockConsumer(ch) }}, nil
+}
+
+// SubscribeSyncStatus delivers a SyncStatus whenever walletd's block or peer
+// counts change. See SubscribeBlocks for ctx's role.
+func (s *Subscriber) SubscribeSyncStatus(ctx context.Context) (<-chan SyncStatus, *subscription, error) {
+	ch := make(chan SyncStatus, 1)
+	s.status.addStatusConsumer(ch)
+	return ch, &subscription{closeFunc: func() { s.status.removeStatusConsumer(ch) }}, nil
+}
+
+// SubscribeTransfers delivers each new Transfer touching addresses as soon
+// as it is observed. The returned channel buffers 8 transfers; a transfer
+// that arrives while it is full is dropped for that subscription and is not
+// resent. Multiple subscriptions for the same address set share a single
+// polling goroutine. See SubscribeBlocks for ctx's role.
+func (s *Subscriber) SubscribeTransfers(ctx context.Context, addresses []string) (<-chan Transfer, *subscription, error) {
+	key := transferPollerKey(addresses)
+
+	s.mu.Lock()
+	poller, ok := s.transferByAddr[key]
+	if !ok {
+		poller = newTransferPoller(s.blocks.ctx, s.c, addresses, s.interval)
+		s.transferByAddr[key] = poller
+	}
+	s.mu.Unlock()
+
+	ch := make(chan Transfer, 8)
+	poller.addConsumer(ch)
+	return ch, &subscription{closeFunc: func() { poller.removeConsumer(ch) }}, nil
+}
+
+// transferPollerKey produces a stable map key for a set of addresses so
+// SubscribeTransfers calls for the same addresses share one poller.
+func transferPollerKey(addresses []string) string {
+	sorted := append([]string(nil), addresses...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
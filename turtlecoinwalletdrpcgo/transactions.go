@@ -0,0 +1,144 @@
+package turtlecoinwalletdrpcgo
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Recipient is a single destination of a transfer, expressed in atomic
+// units (i.e. hundredths of TRTL) to avoid the precision loss of rounding a
+// float64 amount.
+type Recipient struct {
+	Address string
+	Amount  uint64
+}
+
+// SendOptions carries the optional parameters of a transfer beyond its
+// recipients. Zero values are omitted from the walletd request, so leaving
+// Fee or Mixin unset falls back to walletd's own defaults.
+type SendOptions struct {
+	Fee             uint64
+	Mixin           int
+	PaymentID       string
+	ChangeAddress   string
+	SourceAddresses []string
+	UnlockTime      uint64
+	Extra           string
+}
+
+// TxResult is the outcome of submitting or building a transaction. Fee
+// echoes the SendOptions.Fee the caller requested; it is 0 if the caller
+// left Fee unset and let walletd pick its own default, since neither
+// sendTransaction nor createDelayedTransaction report back the fee walletd
+// actually charged. Once the transaction is confirmed, look it up with
+// RequestListTransactions and read Transfer.Fee for the real charge.
+type TxResult struct {
+	TransactionHash string
+	Fee             uint64
+}
+
+// params builds the walletd "transfers" argument plus every optional field
+// present in opts.
+func (opts SendOptions) params(recipients []Recipient) map[string]interface{} {
+	transfers := make([]map[string]interface{}, len(recipients))
+	for i, r := range recipients {
+		transfers[i] = map[string]interface{}{
+			"amount":  r.Amount,
+			"address": r.Address,
+		}
+	}
+
+	params := map[string]interface{}{
+		"transfers": transfers,
+	}
+	if opts.Fee != 0 {
+		params["fee"] = opts.Fee
+	}
+	if opts.Mixin != 0 {
+		params["anonymity"] = opts.Mixin
+	}
+	if opts.PaymentID != "" {
+		params["paymentId"] = opts.PaymentID
+	}
+	if opts.ChangeAddress != "" {
+		params["changeAddress"] = opts.ChangeAddress
+	}
+	if len(opts.SourceAddresses) > 0 {
+		params["addresses"] = opts.SourceAddresses
+	}
+	if opts.UnlockTime != 0 {
+		params["unlockTime"] = opts.UnlockTime
+	}
+	if opts.Extra != "" {
+		params["extra"] = opts.Extra
+	}
+	return params
+}
+
+// SendTransactionMulti sends a transfer to any number of recipients in a
+// single transaction, unlike SendTransaction which only supports one.
+func (c *Client) SendTransactionMulti(ctx context.Context, recipients []Recipient, opts SendOptions) (TxResult, error) {
+	if len(recipients) == 0 {
+		return TxResult{}, errors.New("SendTransactionMulti: no recipients given")
+	}
+
+	var result SendResult
+	if err := c.call(ctx, "sendTransaction", opts.params(recipients), &result); err != nil {
+		return TxResult{}, errors.Wrap(err, "sendTransaction failed")
+	}
+
+	return TxResult{TransactionHash: result.TransactionHash, Fee: opts.Fee}, nil
+}
+
+// delayedTransactionResult mirrors walletd's createDelayedTransaction result.
+type delayedTransactionResult struct {
+	TransactionHash string `json:"transactionHash"`
+}
+
+// delayedTransactionHashesResult mirrors walletd's getDelayedTransactionHashes result.
+type delayedTransactionHashesResult struct {
+	TransactionHashes []string `json:"transactionHashes"`
+}
+
+// CreateDelayedTransaction builds a transaction from recipients and opts
+// without broadcasting it, mirroring an Ethereum raw-tx workflow: the
+// caller can inspect its hash (see TxResult.Fee for why the fee can't be
+// inspected the same way), then decide to SendDelayedTransaction or
+// DeleteDelayedTransaction it.
+func (c *Client) CreateDelayedTransaction(ctx context.Context, recipients []Recipient, opts SendOptions) (TxResult, error) {
+	if len(recipients) == 0 {
+		return TxResult{}, errors.New("CreateDelayedTransaction: no recipients given")
+	}
+
+	var result delayedTransactionResult
+	if err := c.call(ctx, "createDelayedTransaction", opts.params(recipients), &result); err != nil {
+		return TxResult{}, errors.Wrap(err, "createDelayedTransaction failed")
+	}
+
+	return TxResult{TransactionHash: result.TransactionHash, Fee: opts.Fee}, nil
+}
+
+// GetDelayedTransactionHashes lists the hashes of transactions built with
+// CreateDelayedTransaction that have not yet been sent or deleted.
+func (c *Client) GetDelayedTransactionHashes(ctx context.Context) ([]string, error) {
+	var result delayedTransactionHashesResult
+	if err := c.call(ctx, "getDelayedTransactionHashes", nil, &result); err != nil {
+		return nil, errors.Wrap(err, "getDelayedTransactionHashes failed")
+	}
+	return result.TransactionHashes, nil
+}
+
+// SendDelayedTransaction broadcasts a transaction previously built with
+// CreateDelayedTransaction.
+func (c *Client) SendDelayedTransaction(ctx context.Context, transactionHash string) error {
+	params := map[string]interface{}{"transactionHash": transactionHash}
+	return errors.Wrap(c.call(ctx, "sendDelayedTransaction", params, nil), "sendDelayedTransaction failed")
+}
+
+// DeleteDelayedTransaction discards a transaction previously built with
+// CreateDelayedTransaction without ever broadcasting it.
+func (c *Client) DeleteDelayedTransaction(ctx context.Context, transactionHash string) error {
+	params := map[string]interface{}{"transactionHash": transactionHash}
+	return errors.Wrap(c.call(ctx, "deleteDelayedTransaction", params, nil), "deleteDelayedTransaction failed")
+}
@@ -3,7 +3,10 @@ package turtlecoinwalletdrpcgo
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"time"
@@ -13,6 +16,11 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// defaultTimeout is used by NewClient when Config.Timeout is left at its
+// zero value, mirroring the behaviour of Go's http.DefaultClient but
+// bounded so a stuck walletd doesn't hang callers forever.
+const defaultTimeout = 30 * time.Second
+
 // Transfer contains all the information about a specific transfer
 type Transfer struct {
 	PaymentID              string
@@ -25,24 +33,97 @@ type Transfer struct {
 	IsRecievingTransaction bool
 }
 
-var (
-	rpcURL = "http://127.0.0.1:8070/json_rpc"
-)
+// Config carries everything needed to dial a walletd JSON-RPC endpoint.
+type Config struct {
+	// URL is the walletd json_rpc endpoint, e.g. http://127.0.0.1:8070/json_rpc
+	URL string
+	// RPCPassword is walletd's own rpcPassword, sent as part of the request params.
+	RPCPassword string
+	// HTTPBasicUser and HTTPBasicPass, if set, are sent as HTTP basic auth
+	// credentials, for walletd instances fronted by a reverse proxy.
+	HTTPBasicUser string
+	HTTPBasicPass string
+	// TLSConfig, if non-nil, is used for the underlying transport. Leave nil
+	// to talk to a plain-HTTP walletd on localhost.
+	TLSConfig *tls.Config
+	// Timeout bounds every request made by the client. Defaults to
+	// defaultTimeout when zero.
+	Timeout time.Duration
+	// HTTPClient, if set, is used as-is instead of building one from
+	// TLSConfig/Timeout. Mainly useful for tests.
+	HTTPClient *http.Client
+}
+
+// Client is a walletd JSON-RPC client. Unlike the package-level functions,
+// a Client can be pointed at an arbitrary walletd instance, authenticate
+// over TLS or HTTP basic auth, and have its requests cancelled via context.
+type Client struct {
+	url         string
+	rpcPassword string
+	basicUser   string
+	basicPass   string
+	httpClient  *http.Client
+}
+
+// NewClient builds a Client from cfg. A *http.Client is constructed from
+// cfg.TLSConfig and cfg.Timeout unless cfg.HTTPClient is provided.
+func NewClient(cfg Config) *Client {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		timeout := cfg.Timeout
+		if timeout == 0 {
+			timeout = defaultTimeout
+		}
+		httpClient = &http.Client{
+			Timeout: timeout,
+		}
+		if cfg.TLSConfig != nil {
+			httpClient.Transport = &http.Transport{
+				TLSClientConfig: cfg.TLSConfig,
+			}
+		}
+	}
+
+	return &Client{
+		url:         cfg.URL,
+		rpcPassword: cfg.RPCPassword,
+		basicUser:   cfg.HTTPBasicUser,
+		basicPass:   cfg.HTTPBasicPass,
+		httpClient:  httpClient,
+	}
+}
+
+// defaultClient is the package-level Client used by the free functions below,
+// kept for backward compatibility with callers that haven't migrated to
+// constructing their own Client yet.
+var defaultClient = NewClient(Config{URL: "http://127.0.0.1:8070/json_rpc"})
+
+// withPassword returns a shallow copy of c carrying rpcPassword, so the
+// package-level wrapper functions below can pass a per-call password
+// through the shared defaultClient without racing on its fields.
+func (c *Client) withPassword(rpcPassword string) *Client {
+	clone := *c
+	clone.rpcPassword = rpcPassword
+	return &clone
+}
 
 // RequestBalance provides the available and locked balances of the current wallet
 // returned balances are expressed in TRTL, not in 0.01 TRTL
 func RequestBalance(rpcPassword string) (availableBalance float64, lockedBalance float64, totalBalance float64, err error) {
+	return defaultClient.withPassword(rpcPassword).RequestBalance(context.Background())
+}
 
-	args := make(map[string]interface{})
-	payload := rpcPayloadGetBalance(0, rpcPassword, args)
+// RequestBalance provides the available and locked balances of the current wallet
+// returned balances are expressed in TRTL, not in 0.01 TRTL
+func (c *Client) RequestBalance(ctx context.Context) (availableBalance float64, lockedBalance float64, totalBalance float64, err error) {
 
-	responseMap, err := httpRequest(payload)
-	if err != nil {
-		return 0, 0, 0, errors.Wrap(err, "httpRequest failed")
+	var result BalanceResult
+	if err := c.call(ctx, "getBalance", nil, &result); err != nil {
+		return 0, 0, 0, errors.Wrap(err, "getBalance failed")
 	}
 
-	availableBalance = responseMap["result"].(map[string]interface{})["availableBalance"].(float64) / 100
-	lockedBalance = responseMap["result"].(map[string]interface{})["lockedAmount"].(float64) / 100
+	availableBalance = float64(result.AvailableBalance) / 100
+	lockedBalance = float64(result.LockedAmount) / 100
 	totalBalance = availableBalance + lockedBalance
 
 	return availableBalance, lockedBalance, totalBalance, nil
@@ -50,55 +131,52 @@ func RequestBalance(rpcPassword string) (availableBalance float64, lockedBalance
 
 // RequestAddress provides the address of the current wallet
 func RequestAddress(rpcPassword string) (address string, err error) {
+	return defaultClient.withPassword(rpcPassword).RequestAddress(context.Background())
+}
 
-	args := make(map[string]interface{})
-	payload := rpcPayloadGetAddresses(0, rpcPassword, args)
+// RequestAddress provides the address of the current wallet
+func (c *Client) RequestAddress(ctx context.Context) (address string, err error) {
 
-	responseMap, err := httpRequest(payload)
-	if err != nil {
-		return "", errors.Wrap(err, "httpRequest failed")
+	var result AddressesResult
+	if err := c.call(ctx, "getAddresses", nil, &result); err != nil {
+		return "", errors.Wrap(err, "getAddresses failed")
+	}
+	if len(result.Addresses) == 0 {
+		return "", errors.New("getAddresses returned no addresses")
 	}
 
-	walletAddresses := responseMap["result"].(map[string]interface{})["addresses"].([]interface{})
-	address = walletAddresses[0].(string)
-	return address, nil
+	return result.Addresses[0], nil
 }
 
 // RequestListTransactions provides the list of transactions of current wallet
 func RequestListTransactions(blockCount int, firstBlockIndex int, addresses []string, rpcPassword string) (transfers []Transfer, err error) {
+	return defaultClient.withPassword(rpcPassword).RequestListTransactions(context.Background(), blockCount, firstBlockIndex, addresses)
+}
 
-	args := make(map[string]interface{})
-	args["blockCount"] = blockCount
-	args["firstBlockIndex"] = firstBlockIndex
-	args["addresses"] = addresses
-	payload := rpcPayloadGetTransactions(0, rpcPassword, args)
+// RequestListTransactions provides the list of transactions of current wallet
+func (c *Client) RequestListTransactions(ctx context.Context, blockCount int, firstBlockIndex int, addresses []string) (transfers []Transfer, err error) {
 
-	responseMap, err := httpRequest(payload)
-	if err != nil {
-		return nil, errors.Wrap(err, "httpRequest failed")
+	params := map[string]interface{}{
+		"blockCount":      blockCount,
+		"firstBlockIndex": firstBlockIndex,
+		"addresses":       addresses,
 	}
 
-	if responseMap["result"] == nil {
-		return nil, nil
+	var result TransactionsResult
+	if err := c.call(ctx, "getTransactions", params, &result); err != nil {
+		return nil, errors.Wrap(err, "getTransactions failed")
 	}
 
-	blocks := responseMap["result"].(map[string]interface{})["items"].([]interface{})
-
-	for _, block := range blocks {
-
-		transactions := block.(map[string]interface{})["transactions"].([]interface{})
-
-		for _, transaction := range transactions {
-
-			mapTransaction := transaction.(map[string]interface{})
+	for _, block := range result.Items {
+		for _, transaction := range block.Transactions {
 
 			var transfer Transfer
-			transfer.PaymentID = mapTransaction["paymentId"].(string)
-			transfer.TxID = mapTransaction["transactionHash"].(string)
-			transfer.Timestamp = time.Unix(int64(mapTransaction["timestamp"].(float64)), 0)
-			transfer.Amount = mapTransaction["amount"].(float64) / 100
-			transfer.Fee = mapTransaction["fee"].(float64) / 100
-			transfer.Block = int(mapTransaction["blockIndex"].(float64))
+			transfer.PaymentID = transaction.PaymentID
+			transfer.TxID = transaction.TransactionHash
+			transfer.Timestamp = time.Unix(transaction.Timestamp, 0)
+			transfer.Amount = float64(transaction.Amount) / 100
+			transfer.Fee = float64(transaction.Fee) / 100
+			transfer.Block = transaction.BlockIndex
 			transfer.Confirmations = blockCount - transfer.Block + 1
 			transfer.IsRecievingTransaction = transfer.Amount >= 0
 
@@ -110,133 +188,232 @@ func RequestListTransactions(blockCount int, firstBlockIndex int, addresses []st
 
 // RequestStatus requests walletd connection and sync status
 func RequestStatus(rpcPassword string) (blockCount int, knownBlockCount int, peerCount int, err error) {
+	return defaultClient.withPassword(rpcPassword).RequestStatus(context.Background())
+}
 
-	args := make(map[string]interface{})
-	payload := rpcPayloadGetStatus(0, rpcPassword, args)
+// RequestStatus requests walletd connection and sync status
+func (c *Client) RequestStatus(ctx context.Context) (blockCount int, knownBlockCount int, peerCount int, err error) {
 
-	responseMap, err := httpRequest(payload)
-	if err != nil {
-		return 0, 0, 0, errors.Wrap(err, "httpRequest failed")
+	var result StatusResult
+	if err := c.call(ctx, "getStatus", nil, &result); err != nil {
+		return 0, 0, 0, errors.Wrap(err, "getStatus failed")
 	}
 
-	log.Debug("get status: ", responseMap)
-
-	blockCount = int(responseMap["result"].(map[string]interface{})["blockCount"].(float64))
-	knownBlockCount = int(responseMap["result"].(map[string]interface{})["knownBlockCount"].(float64))
-	peerCount = int(responseMap["result"].(map[string]interface{})["peerCount"].(float64))
+	log.Debug("get status: ", result)
 
-	return blockCount, knownBlockCount, peerCount, nil
+	return result.BlockCount, result.KnownBlockCount, result.PeerCount, nil
 }
 
 // SendTransaction makes a transfer with the provided information.
 // parameters amount and fee are expressed in TRTL, not 0.01 TRTL
 func SendTransaction(addressRecipient string, amount float64, paymentID string, fee float64, mixin int, rpcPassword string) (transactionHash string, err error) {
+	return defaultClient.withPassword(rpcPassword).SendTransaction(context.Background(), addressRecipient, amount, paymentID, fee, mixin)
+}
+
+// SendTransaction makes a transfer with the provided information.
+// parameters amount and fee are expressed in TRTL, not 0.01 TRTL
+func (c *Client) SendTransaction(ctx context.Context, addressRecipient string, amount float64, paymentID string, fee float64, mixin int) (transactionHash string, err error) {
 
 	amountInt := int(amount * 100) // expressed in hundredth of TRTL
 	feeInt := int(fee * 100)       // expressed in hundredth of TRTL
 
-	args := make(map[string]interface{})
-	args["fee"] = feeInt
-	args["paymentId"] = paymentID
-	args["anonymity"] = mixin
-	var transfers [1]map[string]interface{}
-	transfer := make(map[string]interface{})
-	transfer["amount"] = amountInt
-	transfer["address"] = addressRecipient
-	transfers[0] = transfer
-	args["transfers"] = transfers
-
-	payload := rpcPayloadSendTransaction(0, rpcPassword, args)
-
-	responseMap, err := httpRequest(payload)
-	if err != nil {
-		return "", errors.Wrap(err, "httpRequest failed")
+	params := map[string]interface{}{
+		"fee":       feeInt,
+		"paymentId": paymentID,
+		"anonymity": mixin,
+		"transfers": [1]map[string]interface{}{
+			{"amount": amountInt, "address": addressRecipient},
+		},
 	}
 
-	responseError := responseMap["error"]
-	if responseError != nil {
-		return "", errors.Wrap(errors.New(responseError.(map[string]interface{})["message"].(string)), "response with error")
+	var result SendResult
+	if err := c.call(ctx, "sendTransaction", params, &result); err != nil {
+		return "", errors.Wrap(err, "sendTransaction failed")
 	}
-	return responseMap["result"].(map[string]interface{})["transactionHash"].(string), nil
+
+	return result.TransactionHash, nil
 }
 
 // GetViewKey provides the private view key
 func GetViewKey(rpcPassword string) (privateViewKey string, err error) {
+	return defaultClient.withPassword(rpcPassword).GetViewKey(context.Background())
+}
 
-	args := make(map[string]interface{})
-	payload := rpcPayloadGetViewKey(0, rpcPassword, args)
+// GetViewKey provides the private view key
+func (c *Client) GetViewKey(ctx context.Context) (privateViewKey string, err error) {
 
-	responseMap, err := httpRequest(payload)
-	if err != nil {
-		return "", errors.Wrap(err, "httpRequest failed")
+	var result struct {
+		ViewSecretKey string `json:"viewSecretKey"`
+	}
+	if err := c.call(ctx, "getViewKey", nil, &result); err != nil {
+		return "", errors.Wrap(err, "getViewKey failed")
 	}
 
-	privateViewKey = responseMap["result"].(map[string]interface{})["viewSecretKey"].(string)
-	return privateViewKey, nil
+	return result.ViewSecretKey, nil
 }
 
 // GetSpendKeys provides the private and public spend keys
 func GetSpendKeys(address string, rpcPassword string) (spendSecretKey string, spendPublicKey string, err error) {
+	return defaultClient.withPassword(rpcPassword).GetSpendKeys(context.Background(), address)
+}
+
+// GetSpendKeys provides the private and public spend keys
+func (c *Client) GetSpendKeys(ctx context.Context, address string) (spendSecretKey string, spendPublicKey string, err error) {
 
-	args := make(map[string]interface{})
-	args["address"] = address
-	payload := rpcPayloadGetSpendKeys(0, rpcPassword, args)
+	params := map[string]interface{}{"address": address}
 
-	responseMap, err := httpRequest(payload)
-	if err != nil {
-		return "", "", err
+	var result struct {
+		SpendSecretKey string `json:"spendSecretKey"`
+		SpendPublicKey string `json:"spendPublicKey"`
+	}
+	if err := c.call(ctx, "getSpendKeys", params, &result); err != nil {
+		return "", "", errors.Wrap(err, "getSpendKeys failed")
 	}
 
-	spendSecretKey = responseMap["result"].(map[string]interface{})["spendSecretKey"].(string)
-	spendPublicKey = responseMap["result"].(map[string]interface{})["spendSecretKey"].(string)
-	return spendSecretKey, spendPublicKey, nil
+	return result.SpendSecretKey, result.SpendPublicKey, nil
 }
 
 // SaveWallet saves the sync info in the wallet
 func SaveWallet(rpcPassword string) (err error) {
+	return defaultClient.withPassword(rpcPassword).SaveWallet(context.Background())
+}
 
-	args := make(map[string]interface{})
-	payload := rpcPayloadSave(0, rpcPassword, args)
-
-	_, err = httpRequest(payload)
-	if err != nil {
-		return errors.Wrap(err, "httpRequest failed")
-	}
+// SaveWallet saves the sync info in the wallet
+func (c *Client) SaveWallet(ctx context.Context) (err error) {
+	return errors.Wrap(c.call(ctx, "save", nil, nil), "save failed")
+}
 
-	return nil
+// jsonRPCRequest is the envelope walletd expects for every call.
+type jsonRPCRequest struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      string      `json:"id"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
 }
 
-func httpRequest(payload rpcPayload) (responseMap map[string]interface{}, err error) {
+// jsonRPCResponse is the envelope walletd wraps every result or error in.
+type jsonRPCResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *ErrorResponse  `json:"error"`
+}
 
-	payloadjson, err := json.Marshal(payload)
+// call marshals a JSON-RPC 2.0 request for method, merging c.rpcPassword
+// into params, and decodes the result into out (which may be nil if the
+// caller doesn't need the result). JSON-RPC level errors are returned as
+// *RPCError rather than a plain error, so callers can errors.As on them.
+func (c *Client) call(ctx context.Context, method string, params map[string]interface{}, out interface{}) error {
+	if params == nil {
+		params = make(map[string]interface{})
+	}
+	params["password"] = c.rpcPassword
+
+	reqBody, err := json.Marshal(jsonRPCRequest{
+		JSONRPC: "2.0",
+		ID:      "1",
+		Method:  method,
+		Params:  params,
+	})
 	if err != nil {
-		log.Fatal("error json marshal: ", err)
+		return errors.Wrap(err, "error json marshal")
 	}
 
-	req, err := http.NewRequest("POST", rpcURL, bytes.NewBuffer(payloadjson))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.url, bytes.NewBuffer(reqBody))
 	if err != nil {
-		log.Fatal("error creating http request: ", err)
+		return errors.Wrap(err, "error creating http request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.basicUser != "" {
+		req.SetBasicAuth(c.basicUser, c.basicPass)
 	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return errors.Wrap(err, "error performing http request")
 	}
 	defer resp.Body.Close()
 
-	responseBody, err := ioutil.ReadAll(resp.Body)
+	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Fatal("error reading result from rpc request getSpendKey:", err)
-	} else {
-		var responseBodyInterface interface{}
-		if err := json.Unmarshal(responseBody, &responseBodyInterface); err != nil {
-			log.Fatal("JSON unmarshaling with interface failed:", err)
-		} else {
-			responseMap := responseBodyInterface.(map[string]interface{})
-			return responseMap, nil
-		}
+		return errors.Wrap(err, "error reading rpc response body")
+	}
+
+	var env jsonRPCResponse
+	if err := json.Unmarshal(respBody, &env); err != nil {
+		return errors.Wrap(err, "json unmarshaling rpc response failed")
+	}
+
+	if env.Error != nil {
+		return &RPCError{Method: method, ErrorResponse: env.Error}
+	}
+
+	if out == nil || len(env.Result) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(env.Result, out); err != nil {
+		return errors.Wrap(err, "error decoding rpc result")
 	}
+	return nil
+}
+
+// ErrorResponse mirrors the "error" member of a walletd JSON-RPC 2.0 response.
+type ErrorResponse struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// RPCError is returned by Client methods when walletd answers with a
+// JSON-RPC error instead of a result. Callers can errors.As(err, &rpcErr)
+// to inspect the underlying Code/Message/Data.
+type RPCError struct {
+	// Method is the JSON-RPC method that produced the error.
+	Method string
+	*ErrorResponse
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("walletd rpc error calling %s: %d %s", e.Method, e.Code, e.Message)
+}
+
+// BalanceResult mirrors walletd's getBalance result.
+type BalanceResult struct {
+	AvailableBalance int64 `json:"availableBalance"`
+	LockedAmount     int64 `json:"lockedAmount"`
+}
+
+// AddressesResult mirrors walletd's getAddresses result.
+type AddressesResult struct {
+	Addresses []string `json:"addresses"`
+}
+
+// StatusResult mirrors walletd's getStatus result.
+type StatusResult struct {
+	BlockCount      int `json:"blockCount"`
+	KnownBlockCount int `json:"knownBlockCount"`
+	PeerCount       int `json:"peerCount"`
+}
+
+// SendResult mirrors walletd's sendTransaction result.
+type SendResult struct {
+	TransactionHash string `json:"transactionHash"`
+}
+
+// transactionBlock is one entry of TransactionsResult.Items.
+type transactionBlock struct {
+	Transactions []transactionItem `json:"transactions"`
+}
+
+// transactionItem mirrors a single transaction as returned by getTransactions.
+type transactionItem struct {
+	TransactionHash string `json:"transactionHash"`
+	PaymentID       string `json:"paymentId"`
+	Timestamp       int64  `json:"timestamp"`
+	Amount          int64  `json:"amount"`
+	Fee             int64  `json:"fee"`
+	BlockIndex      int    `json:"blockIndex"`
+}
 
-	return nil, errors.New("unknown error")
+// TransactionsResult mirrors walletd's getTransactions result.
+type TransactionsResult struct {
+	Items []transactionBlock `json:"items"`
 }
@@ -0,0 +1,52 @@
+package walletgrpc
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authorizationMetadataKey is the gRPC metadata key clients must set to
+// "Bearer <token>" on every call when per-RPC auth is enabled.
+const authorizationMetadataKey = "authorization"
+
+// TokenAuthInterceptor returns a grpc.UnaryServerInterceptor that rejects
+// any unary call whose "authorization" metadata isn't "Bearer token".
+func TokenAuthInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkToken(ctx, token); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamTokenAuthInterceptor is TokenAuthInterceptor's counterpart for the
+// server-streaming Subscribe* RPCs.
+func StreamTokenAuthInterceptor(token string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkToken(ss.Context(), token); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// checkToken reports a codes.Unauthenticated error unless ctx carries a
+// single "authorization: Bearer token" metadata entry.
+func checkToken(ctx context.Context, token string) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	values := md.Get(authorizationMetadataKey)
+	if len(values) != 1 || subtle.ConstantTimeCompare([]byte(values[0]), []byte("Bearer "+token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid or missing bearer token")
+	}
+	return nil
+}
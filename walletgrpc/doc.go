@@ -0,0 +1,5 @@
+// Package walletgrpc exposes turtlecoinwalletdrpcgo.Client over gRPC, so
+// non-Go clients can drive walletd without hand-rolling JSON-RPC calls.
+package walletgrpc
+
+//go:generate protoc --go_out=./walletgrpcpb --go_opt=paths=source_relative --go-grpc_out=./walletgrpcpb --go-grpc_opt=paths=source_relative walletservice.proto
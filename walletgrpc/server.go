@@ -0,0 +1,246 @@
+package walletgrpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/anoncoinonline/Anoncoin-Wallet/turtlecoinwalletdrpcgo"
+	"github.com/anoncoinonline/Anoncoin-Wallet/walletgrpc/walletgrpcpb"
+)
+
+// walletd error codes below mirror WalletServiceErrorCode in turtlecoin's
+// PaymentServiceJsonRpcMessages.h; only the codes this server needs to
+// distinguish from an opaque failure are listed here.
+const (
+	walletdErrWrongAddress     = -4
+	walletdErrWrongAmount      = -5
+	walletdErrNotEnoughBalance = -7
+	walletdErrWrongPaymentID   = -8
+)
+
+// Server implements walletgrpcpb.WalletServiceServer by delegating every
+// RPC to a turtlecoinwalletdrpcgo.Client pointed at a single walletd
+// instance, so it can be wired up as-is by cmd/anoncoin-walletgrpc.
+type Server struct {
+	walletgrpcpb.UnimplementedWalletServiceServer
+
+	client     *turtlecoinwalletdrpcgo.Client
+	subscriber *turtlecoinwalletdrpcgo.Subscriber
+}
+
+// NewServer returns a Server that drives client. A single Subscriber is
+// built up front so concurrent Subscribe* calls for the same wallet share
+// client's polling goroutines rather than starting their own.
+func NewServer(client *turtlecoinwalletdrpcgo.Client) *Server {
+	return &Server{
+		client:     client,
+		subscriber: client.Subscriber(),
+	}
+}
+
+// Balance reports the aggregate available, locked, and total balance of the
+// whole wallet container.
+func (s *Server) Balance(ctx context.Context, req *walletgrpcpb.BalanceRequest) (*walletgrpcpb.BalanceReply, error) {
+	available, locked, total, err := s.client.RequestBalance(ctx)
+	if err != nil {
+		return nil, translateErr(err)
+	}
+
+	return &walletgrpcpb.BalanceReply{
+		AvailableBalance: available,
+		LockedBalance:    locked,
+		TotalBalance:     total,
+	}, nil
+}
+
+// Addresses lists every address held in the wallet container.
+func (s *Server) Addresses(ctx context.Context, req *walletgrpcpb.AddressesRequest) (*walletgrpcpb.AddressesReply, error) {
+	addresses, err := s.client.ListAddresses(ctx)
+	if err != nil {
+		return nil, translateErr(err)
+	}
+
+	return &walletgrpcpb.AddressesReply{Addresses: addresses}, nil
+}
+
+// Transactions lists the transfers touching req.Addresses since
+// req.FirstBlockIndex.
+func (s *Server) Transactions(ctx context.Context, req *walletgrpcpb.TransactionsRequest) (*walletgrpcpb.TransactionsReply, error) {
+	transfers, err := s.client.RequestListTransactions(ctx, int(req.BlockCount), int(req.FirstBlockIndex), req.Addresses)
+	if err != nil {
+		return nil, translateErr(err)
+	}
+
+	reply := &walletgrpcpb.TransactionsReply{Transfers: make([]*walletgrpcpb.Transfer, len(transfers))}
+	for i, t := range transfers {
+		reply.Transfers[i] = &walletgrpcpb.Transfer{
+			PaymentId:              t.PaymentID,
+			TxId:                   t.TxID,
+			Timestamp:              t.Timestamp.Unix(),
+			Amount:                 t.Amount,
+			Fee:                    t.Fee,
+			Block:                  int32(t.Block),
+			Confirmations:          int32(t.Confirmations),
+			IsReceivingTransaction: t.IsRecievingTransaction,
+		}
+	}
+	return reply, nil
+}
+
+// SendTransaction submits a single-recipient transfer and returns its
+// transaction hash.
+func (s *Server) SendTransaction(ctx context.Context, req *walletgrpcpb.SendTransactionRequest) (*walletgrpcpb.SendTransactionReply, error) {
+	hash, err := s.client.SendTransaction(ctx, req.AddressRecipient, req.Amount, req.PaymentId, req.Fee, int(req.Mixin))
+	if err != nil {
+		return nil, translateErr(err)
+	}
+
+	return &walletgrpcpb.SendTransactionReply{TransactionHash: hash}, nil
+}
+
+// Status reports walletd's connection and sync status.
+func (s *Server) Status(ctx context.Context, req *walletgrpcpb.StatusRequest) (*walletgrpcpb.StatusReply, error) {
+	blockCount, knownBlockCount, peerCount, err := s.client.RequestStatus(ctx)
+	if err != nil {
+		return nil, translateErr(err)
+	}
+
+	return &walletgrpcpb.StatusReply{
+		BlockCount:      int32(blockCount),
+		KnownBlockCount: int32(knownBlockCount),
+		PeerCount:       int32(peerCount),
+	}, nil
+}
+
+// SubscribeBlocks streams a BlockEvent every time the observed block count
+// advances or a reorg is detected, until the client cancels the stream.
+func (s *Server) SubscribeBlocks(req *walletgrpcpb.SubscribeBlocksRequest, stream walletgrpcpb.WalletService_SubscribeBlocksServer) error {
+	ctx := stream.Context()
+
+	ch, sub, err := s.subscriber.SubscribeBlocks(ctx)
+	if err != nil {
+		return translateErr(err)
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&walletgrpcpb.BlockEvent{
+				BlockCount:      int32(event.BlockCount),
+				KnownBlockCount: int32(event.KnownBlockCount),
+				Reorg:           event.Reorg,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeSyncStatus streams a SyncStatusEvent every time walletd's block
+// or peer counts change, until the client cancels the stream.
+func (s *Server) SubscribeSyncStatus(req *walletgrpcpb.SubscribeSyncStatusRequest, stream walletgrpcpb.WalletService_SubscribeSyncStatusServer) error {
+	ctx := stream.Context()
+
+	ch, sub, err := s.subscriber.SubscribeSyncStatus(ctx)
+	if err != nil {
+		return translateErr(err)
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&walletgrpcpb.SyncStatusEvent{
+				BlockCount:      int32(event.BlockCount),
+				KnownBlockCount: int32(event.KnownBlockCount),
+				PeerCount:       int32(event.PeerCount),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeTransfers streams a TransferEvent for every new transfer
+// touching req.Addresses, until the client cancels the stream.
+func (s *Server) SubscribeTransfers(req *walletgrpcpb.SubscribeTransfersRequest, stream walletgrpcpb.WalletService_SubscribeTransfersServer) error {
+	ctx := stream.Context()
+
+	ch, sub, err := s.subscriber.SubscribeTransfers(ctx, req.Addresses)
+	if err != nil {
+		return translateErr(err)
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case transfer, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&walletgrpcpb.TransferEvent{
+				Transfer: &walletgrpcpb.Transfer{
+					PaymentId:              transfer.PaymentID,
+					TxId:                   transfer.TxID,
+					Timestamp:              transfer.Timestamp.Unix(),
+					Amount:                 transfer.Amount,
+					Fee:                    transfer.Fee,
+					Block:                  int32(transfer.Block),
+					Confirmations:          int32(transfer.Confirmations),
+					IsReceivingTransaction: transfer.IsRecievingTransaction,
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// translateErr maps an error returned by turtlecoinwalletdrpcgo into a
+// grpc/status error with an appropriate code, so gRPC clients can branch on
+// codes.* instead of parsing error strings.
+func translateErr(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var rpcErr *turtlecoinwalletdrpcgo.RPCError
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.Code {
+		case walletdErrWrongAddress, walletdErrWrongAmount, walletdErrWrongPaymentID:
+			return status.Error(codes.InvalidArgument, rpcErr.Error())
+		case walletdErrNotEnoughBalance:
+			return status.Error(codes.FailedPrecondition, rpcErr.Error())
+		default:
+			return status.Error(codes.Unknown, rpcErr.Error())
+		}
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	// Anything else (dial failures, timeouts, malformed responses) is a
+	// transport-level problem between this server and walletd, not
+	// something the caller did wrong.
+	return status.Error(codes.Unavailable, err.Error())
+}